@@ -0,0 +1,36 @@
+package bme280
+
+import (
+	"fmt"
+	"math"
+)
+
+// Default sea-level reference pressure in hPa, as used by the international
+// barometric formula.
+const defaultSeaLevelPressure = 1013.25
+
+// SetSeaLevelPressure sets the reference sea-level pressure (in hPa) used by
+// Altitude. Callers in a fixed location typically obtain this value from a
+// local weather station to improve accuracy.
+func (d *Driver) SetSeaLevelPressure(hPa float64) {
+	d.seaLevelPressure = hPa
+}
+
+// Altitude returns the altitude in meters derived from the most recent
+// compensated pressure reading, using the international barometric formula
+// and the configured sea-level reference pressure.
+func (d *Driver) Altitude() (float64, error) {
+	if !d.initialized {
+		return 0, fmt.Errorf("driver uninitialized")
+	}
+
+	if d.lastPressure == 0 {
+		return 0, fmt.Errorf("no pressure reading available, call Read first")
+	}
+
+	return altitudeFromPressure(d.lastPressure, d.seaLevelPressure), nil
+}
+
+func altitudeFromPressure(pressure, seaLevelPressure float64) float64 {
+	return 44330 * (1 - math.Pow(pressure/seaLevelPressure, 1/5.255))
+}
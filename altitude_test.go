@@ -0,0 +1,22 @@
+package bme280
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAltitudeFromPressure(t *testing.T) {
+	alt := altitudeFromPressure(1013.25, 1013.25)
+	if math.Abs(alt) > 0.001 {
+		t.Errorf("expected ~0m at reference pressure, got %f", alt)
+	}
+}
+
+func TestAltitudeUninitialized(t *testing.T) {
+	driver := New(&nullBus{})
+
+	_, err := driver.Altitude()
+	if err == nil || err.Error() != "driver uninitialized" {
+		t.Errorf("no or unexpected error: %v", err)
+	}
+}
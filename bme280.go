@@ -3,11 +3,11 @@ package bme280
 //go:generate stringer -type Mode,Filter,StandByTime,Oversampling -output strings.go
 
 import (
+	"context"
 	"fmt"
 	"time"
 	"encoding/binary"
 	"github.com/quhar/bme280"
-	"math"
 )
 
 type bus interface {
@@ -87,9 +87,16 @@ func init() {
 }
 
 type Driver struct {
-	device      bus
-	mode        Mode // Desired operation mode
-	initialized bool
+	device           Transport
+	mode             Mode // Desired operation mode
+	initialized      bool
+	seaLevelPressure float64 // Reference pressure (hPa) used by Altitude
+	lastPressure     float64 // Most recent compensated pressure reading (hPa)
+	readings         chan Response
+	errs             chan error
+	quit             chan struct{}
+	logger           Logger
+	retryPolicy      RetryPolicy
 	calib struct {
 		t1    uint16
 		t2    int16
@@ -133,18 +140,38 @@ type ucompData struct {
 	hum   uint32
 }
 
-func New(device bus) *Driver {
-	return &Driver{
-		device: device,
+// New constructs a Driver over an I2C bus, via NewI2C. Use NewSPI and pass
+// the resulting Transport directly for a chip wired over SPI.
+func New(device bus, opts ...Option) *Driver {
+	d := &Driver{
+		device:           NewI2C(device),
+		seaLevelPressure: defaultSeaLevelPressure,
+		logger:           noopLogger{},
+		retryPolicy:      defaultRetryPolicy,
 	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
 }
 
 func (d *Driver) Init() error {
+	return d.InitContext(context.Background())
+}
+
+// InitContext behaves like Init but gives up as soon as ctx is done, instead
+// of blocking through the chip-ID retry loop and the post-reset delay. The
+// retry count and backoff between attempts come from the driver's
+// RetryPolicy (see WithRetryPolicy).
+func (d *Driver) InitContext(ctx context.Context) error {
 	// This function follows the official driver bme280_init method algorithm
 	buf := make([]byte, 1)
-	retries := 5
+	retries := d.retryPolicy.MaxAttempts
 	for {
 		err := d.device.ReadReg(chipIdAddr, buf)
+		d.logger.Debugf("read chipId register: %X, err: %v", buf[0], err)
 		if err != nil || buf[0] != chipId {
 			if retries == 0 {
 				if err == nil {
@@ -153,12 +180,15 @@ func (d *Driver) Init() error {
 				return err
 			}
 			retries--
+			if err := sleepContext(ctx, d.retryPolicy.Backoff); err != nil {
+				return err
+			}
 			continue
 		}
 		break
 	}
 
-	err := d.softReset()
+	err := d.softReset(ctx)
 	if err != nil {
 		return err
 	}
@@ -168,7 +198,9 @@ func (d *Driver) Init() error {
 		return err
 	}
 
-	time.Sleep(1 * time.Millisecond)
+	if err := sleepContext(ctx, 1*time.Millisecond); err != nil {
+		return err
+	}
 	d.initialized = true
 	return nil
 }
@@ -227,6 +259,7 @@ func (d *Driver) SetMode(m Mode) error {
 		return nil
 	}
 
+	d.logger.Debugf("set mode: %v (was %v)", m, lastMode)
 	d.mode = m
 
 	return nil
@@ -280,12 +313,18 @@ func (d *Driver) GetMode() (Mode, error) {
 
 // Puts the device to sleep
 func (d *Driver) Sleep() error {
+	return d.SleepContext(context.Background())
+}
+
+// SleepContext behaves like Sleep but gives up as soon as ctx is done,
+// instead of blocking through the soft-reset delay.
+func (d *Driver) SleepContext(ctx context.Context) error {
 	settings, err := d.GetSettings()
 	if err != nil {
 		return err
 	}
 
-	err = d.softReset()
+	err = d.softReset(ctx)
 	if err != nil {
 		return err
 	}
@@ -294,15 +333,25 @@ func (d *Driver) Sleep() error {
 }
 
 func (d *Driver) Read() (Response, error) {
+	return d.ReadContext(context.Background())
+}
+
+// ReadContext behaves like Read but gives up as soon as ctx is done, instead
+// of blocking through the forced-mode measurement delay.
+func (d *Driver) ReadContext(ctx context.Context) (Response, error) {
+	if !d.initialized {
+		return Response{}, fmt.Errorf("driver uninitialized")
+	}
+
 	if d.mode == ModeForced {
-		err := d.forceMeasurement()
+		err := d.forceMeasurement(ctx)
 		if err != nil {
 			return Response{}, err
 		}
 	}
 
 	buf := make([]byte, 8)
-	err := d.device.ReadReg(dataAddr, buf)
+	err := d.readRegisters(dataAddr, buf)
 	if err != nil {
 		return Response{}, err
 	}
@@ -312,12 +361,16 @@ func (d *Driver) Read() (Response, error) {
 		uint32(buf[0])<<12 | uint32(buf[1])<<4 | uint32(buf[2])>>4,
 		uint32(buf[6])<<8 | uint32(buf[7]),
 	}
+	d.logger.Debugf("read raw sample: %+v", u)
 
 	temp, tFine := d.compensateTemperature(u.temp)
 	d.calib.tFine = tFine
 	pressure := d.compensatePressure(u.press)
+	d.lastPressure = pressure
 	humidity := d.compensateHumidity(u.hum)
 
+	d.logger.Debugf("compensated sample: temp=%.2f pressure=%.2f humidity=%.2f", temp, pressure, humidity)
+
 	return Response{
 		temp,
 		pressure,
@@ -412,7 +465,7 @@ func (d *Driver) compensateHumidity(u uint32) float64 {
 	return float64(humidity) / 1000.0
 }
 
-func (d *Driver) softReset() error {
+func (d *Driver) softReset(ctx context.Context) error {
 	var softResetCmd byte = 0xB6
 
 	err := d.device.WriteReg(resetAddr, []byte{softResetCmd})
@@ -420,14 +473,20 @@ func (d *Driver) softReset() error {
 		return err
 	}
 
-	time.Sleep(2 * time.Millisecond) // As per specification, wait 2 milliseconds
-	return nil
+	return sleepContext(ctx, 2*time.Millisecond) // As per specification, wait 2 milliseconds
+}
+
+// readRegisters is the single entry point through which both the I2C and SPI
+// transports are read, so calibration and data window reads behave
+// identically regardless of transport.
+func (d *Driver) readRegisters(reg byte, buf []byte) error {
+	return d.device.ReadReg(reg, buf)
 }
 
 func (d *Driver) readCalibData() error {
 	buf := make([]byte, 26)
 
-	err := d.device.ReadReg(tempPressCalibDataAddr, buf)
+	err := d.readRegisters(tempPressCalibDataAddr, buf)
 	if err != nil {
 		return err
 	}
@@ -447,7 +506,7 @@ func (d *Driver) readCalibData() error {
 	d.calib.h1 = buf[25]
 
 	buf = buf[:7]
-	err = d.device.ReadReg(humidityCalibDataAddr, buf)
+	err = d.readRegisters(humidityCalibDataAddr, buf)
 	if err != nil {
 		return err
 	}
@@ -458,10 +517,12 @@ func (d *Driver) readCalibData() error {
 	d.calib.h5 = int16(int8(buf[5])*16) | int16(buf[4]>>4)
 	d.calib.h6 = int8(buf[6])
 
+	d.logger.Debugf("parsed calibration data: %+v", d.calib)
+
 	return nil
 }
 
-func (d *Driver) forceMeasurement() error {
+func (d *Driver) forceMeasurement(ctx context.Context) error {
 	lastMode, err := d.GetMode()
 	if err != nil {
 		return err
@@ -490,18 +551,12 @@ func (d *Driver) forceMeasurement() error {
 		return err
 	}
 
-	// Using the max measurement time formula
-	tempMeasTime := 2.3 * oversamplingCoefs[int(s.TemperatureOversampling)]
-	pressureMeasTime := 2.3*oversamplingCoefs[int(s.PressureOversampling)] + 0.575
-	humidityMeasTime := 2.3*oversamplingCoefs[int(s.HumidityOversampling)] + 0.575
-	measTime := 1.25 + tempMeasTime + pressureMeasTime + humidityMeasTime
-	measTimeMicros := time.Duration(math.Ceil(float64(measTime * 1000)))
-
-	time.Sleep(measTimeMicros * time.Microsecond)
-	return nil
+	return sleepContext(ctx, maxMeasurementTime(s))
 }
 
 func (d *Driver) loadSettings(s Settings) error {
+	d.logger.Debugf("loading settings: %+v", s)
+
 	buf := make([]byte, 1)
 
 	buf[0] = byte(s.HumidityOversampling & 0x07)
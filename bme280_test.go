@@ -44,13 +44,15 @@ func (b *closerBus) Close() error {
 }
 
 func TestClose(t *testing.T) {
-	b := closerBus{}
-	err := b.Close()
+	b := &closerBus{}
+	driver := New(b)
+
+	err := driver.Close()
 	if err != nil {
 		t.Fatalf("close: %v", err)
 	}
 
 	if !b.closed {
-		t.Errorf("expected closed")
+		t.Errorf("expected underlying bus to be closed")
 	}
 }
@@ -0,0 +1,46 @@
+package bme280
+
+import (
+	"context"
+	"time"
+)
+
+// RetryPolicy controls how InitContext retries the chip-ID handshake before
+// giving up.
+type RetryPolicy struct {
+	MaxAttempts int           // Number of retries after the initial attempt
+	Backoff     time.Duration // Delay between attempts
+}
+
+var defaultRetryPolicy = RetryPolicy{MaxAttempts: 5, Backoff: 0}
+
+// WithRetryPolicy overrides the driver's chip-ID handshake retry policy.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(d *Driver) {
+		d.retryPolicy = p
+	}
+}
+
+// sleepContext waits for d, returning early with ctx.Err() if ctx is done
+// first. It is used in place of time.Sleep wherever the driver would
+// otherwise block a caller that wants to be able to cancel.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+			return nil
+		}
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
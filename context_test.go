@@ -0,0 +1,49 @@
+package bme280
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type failingChipIDBus struct {
+	nullBus
+	reads int
+}
+
+func (b *failingChipIDBus) ReadReg(reg byte, buf []byte) error {
+	if reg == chipIdAddr {
+		b.reads++
+		buf[0] = 0x00 // never matches chipId
+		return nil
+	}
+	return b.nullBus.ReadReg(reg, buf)
+}
+
+func TestInitContextRespectsCancellation(t *testing.T) {
+	bus := &failingChipIDBus{}
+	driver := New(bus, WithRetryPolicy(RetryPolicy{MaxAttempts: 100, Backoff: time.Hour}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := driver.InitContext(ctx)
+	if err == nil {
+		t.Fatal("expected an error from a cancelled context")
+	}
+
+	if bus.reads != 1 {
+		t.Errorf("expected exactly one chip-ID read before backing off into cancellation, got %d", bus.reads)
+	}
+}
+
+func TestSleepContextRespectsCancellation(t *testing.T) {
+	driver := New(&nullBus{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := driver.SleepContext(ctx); err == nil {
+		t.Fatal("expected an error from a cancelled context")
+	}
+}
@@ -0,0 +1,50 @@
+package bme280
+
+import "math"
+
+// DewPoint returns the dew point in degrees Celsius, computed from the
+// response's temperature and relative humidity using the Magnus-Tetens
+// approximation.
+func (r Response) DewPoint() float64 {
+	gamma := math.Log(r.Humidity/100) + (17.625*r.Temperature)/(243.04+r.Temperature)
+	return 243.04 * gamma / (17.625 - gamma)
+}
+
+// AbsoluteHumidity returns the absolute humidity in g/m^3, derived from the
+// response's temperature and relative humidity.
+func (r Response) AbsoluteHumidity() float64 {
+	return 216.7 * (r.Humidity / 100 * 6.112 * math.Exp(17.62*r.Temperature/(243.12+r.Temperature)) / (273.15 + r.Temperature))
+}
+
+// HeatIndex returns the Rothfusz heat index in degrees Celsius, a measure of
+// how hot it feels once humidity is accounted for. Below 27 degrees Celsius
+// (80 degrees Fahrenheit) the regression is unreliable, so the plain
+// temperature is returned instead, matching the NWS reference implementation.
+func (r Response) HeatIndex() float64 {
+	t := celsiusToFahrenheit(r.Temperature)
+	rh := r.Humidity
+
+	if t < 80 {
+		return r.Temperature
+	}
+
+	hi := -42.379 + 2.04901523*t + 10.14333127*rh -
+		0.22475541*t*rh - 0.00683783*t*t - 0.05481717*rh*rh +
+		0.00122874*t*t*rh + 0.00085282*t*rh*rh - 0.00000199*t*t*rh*rh
+
+	if rh < 13 && t >= 80 && t <= 112 {
+		hi -= ((13 - rh) / 4) * math.Sqrt((17-math.Abs(t-95))/17)
+	} else if rh > 85 && t >= 80 && t <= 87 {
+		hi += ((rh - 85) / 10) * ((87 - t) / 5)
+	}
+
+	return fahrenheitToCelsius(hi)
+}
+
+func celsiusToFahrenheit(c float64) float64 {
+	return c*9/5 + 32
+}
+
+func fahrenheitToCelsius(f float64) float64 {
+	return (f - 32) * 5 / 9
+}
@@ -0,0 +1,69 @@
+package bme280
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDewPoint(t *testing.T) {
+	r := Response{Temperature: 25, Humidity: 50}
+
+	got := r.DewPoint()
+	want := 13.86
+
+	if math.Abs(got-want) > 0.1 {
+		t.Errorf("DewPoint() = %f, want ~%f", got, want)
+	}
+}
+
+func TestAbsoluteHumidity(t *testing.T) {
+	r := Response{Temperature: 25, Humidity: 50}
+
+	got := r.AbsoluteHumidity()
+	want := 11.5
+
+	if math.Abs(got-want) > 0.5 {
+		t.Errorf("AbsoluteHumidity() = %f, want ~%f", got, want)
+	}
+}
+
+func TestHeatIndexBelowThreshold(t *testing.T) {
+	r := Response{Temperature: 20, Humidity: 50}
+
+	if got := r.HeatIndex(); got != r.Temperature {
+		t.Errorf("HeatIndex() = %f, want plain temperature %f", got, r.Temperature)
+	}
+}
+
+func TestHeatIndexRegression(t *testing.T) {
+	r := Response{Temperature: 32.2, Humidity: 70}
+
+	got := r.HeatIndex()
+	want := 41.1
+
+	if math.Abs(got-want) > 0.2 {
+		t.Errorf("HeatIndex() = %f, want ~%f", got, want)
+	}
+}
+
+func TestHeatIndexLowHumidityCorrection(t *testing.T) {
+	r := Response{Temperature: 35, Humidity: 10}
+
+	got := r.HeatIndex()
+	want := 31.9
+
+	if math.Abs(got-want) > 0.2 {
+		t.Errorf("HeatIndex() = %f, want ~%f", got, want)
+	}
+}
+
+func TestHeatIndexHighHumidityCorrection(t *testing.T) {
+	r := Response{Temperature: 29, Humidity: 90}
+
+	got := r.HeatIndex()
+	want := 37.2
+
+	if math.Abs(got-want) > 0.2 {
+		t.Errorf("HeatIndex() = %f, want ~%f", got, want)
+	}
+}
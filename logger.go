@@ -0,0 +1,25 @@
+package bme280
+
+// Logger is a minimal structured logging hook for register I/O and sample
+// tracing. It matches the common Debugf shape so it can be backed by zap's
+// SugaredLogger, zerolog, slog, or similar.
+type Logger interface {
+	Debugf(format string, args ...interface{})
+}
+
+type noopLogger struct{}
+
+func (noopLogger) Debugf(string, ...interface{}) {}
+
+// Option configures a Driver at construction time.
+type Option func(*Driver)
+
+// WithLogger makes the driver log, at debug level through l, the chip-ID
+// read in Init, each mode change, each settings load, the parsed calibration
+// table and every raw and compensated sample. Other register reads and
+// writes are not logged.
+func WithLogger(l Logger) Option {
+	return func(d *Driver) {
+		d.logger = l
+	}
+}
@@ -0,0 +1,34 @@
+package bme280
+
+import "testing"
+
+type recordingLogger struct {
+	lines []string
+}
+
+func (l *recordingLogger) Debugf(format string, args ...interface{}) {
+	l.lines = append(l.lines, format)
+}
+
+func TestWithLoggerReceivesDebugLines(t *testing.T) {
+	logger := &recordingLogger{}
+	driver := New(&nullBus{}, WithLogger(logger))
+	driver.initialized = true
+
+	if _, err := driver.Read(); err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+
+	if len(logger.lines) == 0 {
+		t.Errorf("expected at least one debug line to be logged")
+	}
+}
+
+func TestDefaultLoggerIsNoop(t *testing.T) {
+	driver := New(&nullBus{})
+	driver.initialized = true
+
+	if _, err := driver.Read(); err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+}
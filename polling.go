@@ -0,0 +1,81 @@
+package bme280
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// Start begins a background goroutine that reads the sensor every interval
+// and publishes results on the channel returned by Readings (errors are
+// published on the channel returned by Errors instead). Call Close to stop
+// the goroutine and release the underlying bus.
+func (d *Driver) Start(interval time.Duration) error {
+	if d.quit != nil {
+		return fmt.Errorf("already started")
+	}
+
+	d.readings = make(chan Response, 16)
+	d.errs = make(chan error, 16)
+	d.quit = make(chan struct{})
+
+	go d.poll(interval, d.quit)
+
+	return nil
+}
+
+// poll takes quit as a parameter, rather than reading d.quit on every loop
+// iteration, so it never touches that field concurrently with Close clearing
+// it.
+func (d *Driver) poll(interval time.Duration, quit <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			resp, err := d.Read()
+			if err != nil {
+				select {
+				case d.errs <- err:
+				default:
+				}
+				continue
+			}
+
+			select {
+			case d.readings <- resp:
+			default:
+			}
+		case <-quit:
+			return
+		}
+	}
+}
+
+// Readings returns the channel on which background readings are published.
+// It is only valid after a call to Start.
+func (d *Driver) Readings() <-chan Response {
+	return d.readings
+}
+
+// Errors returns the channel on which background read errors are published.
+// It is only valid after a call to Start.
+func (d *Driver) Errors() <-chan error {
+	return d.errs
+}
+
+// Close stops the background polling goroutine started by Start, if any,
+// and closes the underlying bus if it implements io.Closer.
+func (d *Driver) Close() error {
+	if d.quit != nil {
+		close(d.quit)
+		d.quit = nil
+	}
+
+	if closer, ok := d.device.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package bme280
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStartPublishesReadings(t *testing.T) {
+	driver := New(&nullBus{})
+	driver.initialized = true
+
+	if err := driver.Start(time.Millisecond); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	defer driver.Close()
+
+	select {
+	case <-driver.Readings():
+	case err := <-driver.Errors():
+		t.Fatalf("unexpected error: %v", err)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for a reading")
+	}
+}
+
+func TestStartTwiceFails(t *testing.T) {
+	driver := New(&nullBus{})
+
+	if err := driver.Start(time.Minute); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	defer driver.Close()
+
+	if err := driver.Start(time.Minute); err == nil {
+		t.Errorf("expected error starting an already-started driver")
+	}
+}
@@ -0,0 +1,81 @@
+package bme280
+
+import "io"
+
+// Transport abstracts the register-level access the driver needs, regardless
+// of whether the chip is wired over I2C or SPI.
+type Transport interface {
+	ReadReg(reg byte, buf []byte) error
+	WriteReg(reg byte, buf []byte) error
+}
+
+// SPIConn is the minimal full-duplex transfer primitive the SPI transport
+// needs from an underlying SPI bus implementation.
+type SPIConn interface {
+	Transfer(tx []byte) ([]byte, error)
+}
+
+type i2cTransport struct {
+	dev bus
+}
+
+// NewI2C wraps an I2C bus as a Transport. This is the transport New uses.
+func NewI2C(dev bus) Transport {
+	return &i2cTransport{dev: dev}
+}
+
+func (t *i2cTransport) ReadReg(reg byte, buf []byte) error {
+	return t.dev.ReadReg(reg, buf)
+}
+
+func (t *i2cTransport) WriteReg(reg byte, buf []byte) error {
+	return t.dev.WriteReg(reg, buf)
+}
+
+// Close forwards to the wrapped bus if it implements io.Closer, so wrapping
+// a bus in NewI2C (as New does) doesn't hide it from Driver.Close.
+func (t *i2cTransport) Close() error {
+	if closer, ok := t.dev.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// spiRegRead is the register-address MSB convention used by the BME280 over
+// its 4-wire SPI interface: bit 7 set selects a read, bit 7 clear selects a
+// write.
+const spiRegRead = 0x80
+
+type spiTransport struct {
+	spi SPIConn
+}
+
+// NewSPI wraps an SPIConn as a Transport, handling the register-address MSB
+// convention and burst-read semantics the BME280 expects over SPI.
+func NewSPI(spi SPIConn) Transport {
+	return &spiTransport{spi: spi}
+}
+
+func (t *spiTransport) ReadReg(reg byte, buf []byte) error {
+	tx := make([]byte, len(buf)+1)
+	tx[0] = reg | spiRegRead
+
+	rx, err := t.spi.Transfer(tx)
+	if err != nil {
+		return err
+	}
+
+	copy(buf, rx[1:])
+	return nil
+}
+
+func (t *spiTransport) WriteReg(reg byte, buf []byte) error {
+	for i, b := range buf {
+		tx := []byte{(reg + byte(i)) &^ spiRegRead, b}
+		if _, err := t.spi.Transfer(tx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
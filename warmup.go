@@ -0,0 +1,72 @@
+package bme280
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// MaxMeasurementTime returns the worst-case time a measurement can take with
+// the driver's current settings, per the datasheet formula.
+func (d *Driver) MaxMeasurementTime() (time.Duration, error) {
+	s, err := d.GetSettings()
+	if err != nil {
+		return 0, err
+	}
+
+	return maxMeasurementTime(s), nil
+}
+
+func maxMeasurementTime(s Settings) time.Duration {
+	tempMeasTime := 2.3 * oversamplingCoefs[int(s.TemperatureOversampling)]
+	pressureMeasTime := 2.3*oversamplingCoefs[int(s.PressureOversampling)] + 0.575
+	humidityMeasTime := 2.3*oversamplingCoefs[int(s.HumidityOversampling)] + 0.575
+	measTime := 1.25 + tempMeasTime + pressureMeasTime + humidityMeasTime
+
+	return time.Duration(math.Ceil(float64(measTime*1000))) * time.Microsecond
+}
+
+// WarmUp fills the IIR filter before the caller takes its first real
+// reading, avoiding the well-known garbage-first-sample problem when
+// filtering is enabled. It temporarily switches to forced mode, takes as
+// many samples as the filter coefficient (2^k for Filter setting k, e.g. 4
+// for Filter4 and 1 for FilterOff), then restores the previous mode.
+func (d *Driver) WarmUp(ctx context.Context) error {
+	if !d.initialized {
+		return fmt.Errorf("driver uninitialized")
+	}
+
+	settings, err := d.GetSettings()
+	if err != nil {
+		return err
+	}
+
+	originalMode, err := d.GetMode()
+	if err != nil {
+		return err
+	}
+
+	if originalMode != ModeForced {
+		if err := d.SetMode(ModeForced); err != nil {
+			return err
+		}
+		defer d.SetMode(originalMode)
+	}
+
+	samples := 1 << uint(settings.Filter)
+
+	for i := 0; i < samples; i++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		if _, err := d.ReadContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package bme280
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMaxMeasurementTime(t *testing.T) {
+	driver := New(&nullBus{})
+
+	d, err := driver.MaxMeasurementTime()
+	if err != nil {
+		t.Fatalf("MaxMeasurementTime: %v", err)
+	}
+
+	if d <= 0 {
+		t.Errorf("expected a positive measurement time, got %v", d)
+	}
+}
+
+func TestWarmUpUninitialized(t *testing.T) {
+	driver := New(&nullBus{})
+
+	err := driver.WarmUp(context.Background())
+	if err == nil || err.Error() != "driver uninitialized" {
+		t.Errorf("no or unexpected error: %v", err)
+	}
+}
+
+// countingBus is a flat register image that counts reads of the data
+// register, so tests can assert how many samples WarmUp actually took.
+type countingBus struct {
+	regs      [256]byte
+	dataReads int
+}
+
+func (b *countingBus) ReadReg(reg byte, buf []byte) error {
+	for i := range buf {
+		buf[i] = b.regs[int(reg)+i]
+	}
+	if reg == dataAddr {
+		b.dataReads++
+	}
+	return nil
+}
+
+func (b *countingBus) WriteReg(reg byte, buf []byte) error {
+	for i, v := range buf {
+		b.regs[int(reg)+i] = v
+	}
+	return nil
+}
+
+func TestWarmUpReadsEnoughSamplesAndRestoresMode(t *testing.T) {
+	bus := &countingBus{}
+	bus.regs[configAddr] = byte(Filter4) << 2 // filter coefficient 4 (setting 0x02) -> 2^2 = 4 warm-up samples
+
+	driver := New(bus)
+	driver.initialized = true
+
+	originalMode, err := driver.GetMode()
+	if err != nil {
+		t.Fatalf("GetMode: %v", err)
+	}
+	if originalMode != ModeSleep {
+		t.Fatalf("expected driver to start in ModeSleep, got %v", originalMode)
+	}
+
+	if err := driver.WarmUp(context.Background()); err != nil {
+		t.Fatalf("WarmUp: %v", err)
+	}
+
+	wantSamples := 1 << uint(Filter4)
+	if bus.dataReads != wantSamples {
+		t.Errorf("expected %d data reads, got %d", wantSamples, bus.dataReads)
+	}
+
+	gotMode, err := driver.GetMode()
+	if err != nil {
+		t.Fatalf("GetMode: %v", err)
+	}
+	if gotMode != originalMode {
+		t.Errorf("expected mode to be restored to %v, got %v", originalMode, gotMode)
+	}
+}